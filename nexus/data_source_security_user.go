@@ -0,0 +1,158 @@
+/*
+Use this data source to get information about an existing user, e.g. to look
+up an LDAP/SAML-provisioned user by userid so its roles can be referenced
+without declaring the user as a managed resource.
+
+Example Usage
+
+```hcl
+data "nexus_security_user" "admin" {
+  userid = "admin"
+}
+```
+*/
+package nexus
+
+import (
+	"fmt"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceSecurityUser() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSecurityUserRead,
+
+		Schema: map[string]*schema.Schema{
+			"userid": {
+				Description: "The userid to look up. If omitted, `email`, `source` and/or `role` are used to find a single matching user.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"email": {
+				Description: "Filter users by their email address.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"source": {
+				Description: "Filter users by their user source, e.g. `default`, `LDAP` or `SAML`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"role": {
+				Description: "Filter users by membership in this role.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"firstname": {
+				Description: "The first name of the user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"lastname": {
+				Description: "The last name of the user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"roles": {
+				Description: "The roles which the user has been assigned within Nexus.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Type:        schema.TypeSet,
+			},
+			"status": {
+				Description: "The user's status, e.g. active or disabled.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceSecurityUserRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	if userid, ok := d.GetOk("userid"); ok {
+		user, err := client.Security.User.Get(userid.(string))
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return fmt.Errorf("no user found with userid: %s", userid.(string))
+		}
+		return setSecurityUserDataSourceAttributes(d, user)
+	}
+
+	users, err := filterSecurityUsers(client, d.Get("email").(string), d.Get("source").(string), d.Get("role").(string))
+	if err != nil {
+		return err
+	}
+
+	switch len(users) {
+	case 0:
+		return fmt.Errorf("no user found matching the given email/source/role filters")
+	case 1:
+		return setSecurityUserDataSourceAttributes(d, &users[0])
+	default:
+		return fmt.Errorf("%d users match the given email/source/role filters, please narrow your filters or use the plural nexus_security_users data source", len(users))
+	}
+}
+
+func setSecurityUserDataSourceAttributes(d *schema.ResourceData, user *security.User) error {
+	d.SetId(user.UserID)
+	d.Set("userid", user.UserID)
+	d.Set("firstname", user.FirstName)
+	d.Set("lastname", user.LastName)
+	d.Set("email", user.EmailAddress)
+	d.Set("roles", stringSliceToInterfaceSlice(user.Roles))
+	d.Set("status", user.Status)
+	d.Set("source", user.Source)
+	return nil
+}
+
+// filterSecurityUsers lists every user known to Nexus and keeps the ones
+// matching the given email, source and/or role. Empty filter values are
+// ignored.
+func filterSecurityUsers(client *nexus.NexusClient, email string, source string, role string) ([]security.User, error) {
+	users, err := client.Security.User.List()
+	if err != nil {
+		return nil, err
+	}
+
+	return matchSecurityUsers(users, email, source, role), nil
+}
+
+// matchSecurityUsers keeps the users matching the given email, source and/or
+// role out of the given list. Empty filter values are ignored. Split out of
+// filterSecurityUsers so the matching logic can be unit-tested without a
+// Nexus client.
+func matchSecurityUsers(users []security.User, email string, source string, role string) []security.User {
+	filtered := make([]security.User, 0, len(users))
+	for _, user := range users {
+		if email != "" && user.EmailAddress != email {
+			continue
+		}
+		if source != "" && user.Source != source {
+			continue
+		}
+		if role != "" && !stringSliceContains(user.Roles, role) {
+			continue
+		}
+		filtered = append(filtered, user)
+	}
+	return filtered
+}
+
+func stringSliceContains(haystack []string, needle string) bool {
+	for _, item := range haystack {
+		if item == needle {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package nexus
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+)
+
+func TestMatchSecurityUsers(t *testing.T) {
+	users := []security.User{
+		{UserID: "jdoe", EmailAddress: "jdoe@example.com", Source: "default", Roles: []string{"nx-admin"}},
+		{UserID: "asmith", EmailAddress: "asmith@example.com", Source: "LDAP", Roles: []string{"nx-deploy"}},
+		{UserID: "bwong", EmailAddress: "bwong@example.com", Source: "LDAP", Roles: []string{"nx-admin", "nx-deploy"}},
+	}
+
+	cases := []struct {
+		name   string
+		email  string
+		source string
+		role   string
+		want   []string
+	}{
+		{
+			name: "no filters returns everyone",
+			want: []string{"jdoe", "asmith", "bwong"},
+		},
+		{
+			name:  "filter by email",
+			email: "asmith@example.com",
+			want:  []string{"asmith"},
+		},
+		{
+			name:   "filter by source",
+			source: "LDAP",
+			want:   []string{"asmith", "bwong"},
+		},
+		{
+			name: "filter by role",
+			role: "nx-admin",
+			want: []string{"jdoe", "bwong"},
+		},
+		{
+			name:   "combined filters",
+			source: "LDAP",
+			role:   "nx-admin",
+			want:   []string{"bwong"},
+		},
+		{
+			name:  "no match",
+			email: "nobody@example.com",
+			want:  []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			matched := matchSecurityUsers(users, tc.email, tc.source, tc.role)
+
+			got := make([]string, len(matched))
+			for i, user := range matched {
+				got[i] = user.UserID
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("matchSecurityUsers() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStringSliceContains(t *testing.T) {
+	if !stringSliceContains([]string{"a", "b"}, "b") {
+		t.Error("expected stringSliceContains to find \"b\"")
+	}
+	if stringSliceContains([]string{"a", "b"}, "c") {
+		t.Error("expected stringSliceContains not to find \"c\"")
+	}
+	if stringSliceContains(nil, "a") {
+		t.Error("expected stringSliceContains on a nil slice to return false")
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Use this data source to get information about all existing users matching a
+set of filters, e.g. to discover every LDAP-provisioned user holding a given
+role.
+
+Example Usage
+
+```hcl
+data "nexus_security_users" "ldap_admins" {
+  source = "LDAP"
+  role   = "nx-admin"
+}
+```
+*/
+package nexus
+
+import (
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceSecurityUsers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSecurityUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Description: "Filter users by their email address.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"source": {
+				Description: "Filter users by their user source, e.g. `default`, `LDAP` or `SAML`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"role": {
+				Description: "Filter users by membership in this role.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"users": {
+				Description: "The users matching the given filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"userid": {
+							Description: "The userid which is required for login.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"firstname": {
+							Description: "The first name of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"lastname": {
+							Description: "The last name of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email": {
+							Description: "The email address associated with the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"source": {
+							Description: "The user source, e.g. `default`, `LDAP` or `SAML`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"roles": {
+							Description: "The roles which the user has been assigned within Nexus.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Computed:    true,
+							Type:        schema.TypeSet,
+						},
+						"status": {
+							Description: "The user's status, e.g. active or disabled.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSecurityUsersRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	users, err := filterSecurityUsers(client, d.Get("email").(string), d.Get("source").(string), d.Get("role").(string))
+	if err != nil {
+		return err
+	}
+
+	flattened := make([]map[string]interface{}, len(users))
+	for i, user := range users {
+		flattened[i] = map[string]interface{}{
+			"userid":    user.UserID,
+			"firstname": user.FirstName,
+			"lastname":  user.LastName,
+			"email":     user.EmailAddress,
+			"source":    user.Source,
+			"roles":     stringSliceToInterfaceSlice(user.Roles),
+			"status":    user.Status,
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	return d.Set("users", flattened)
+}
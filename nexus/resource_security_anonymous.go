@@ -0,0 +1,106 @@
+/*
+Use this resource to manage Nexus's anonymous access settings. This is a
+singleton: Nexus always has exactly one anonymous access configuration, so
+Create adopts the existing configuration and Delete only removes it from
+Terraform state, leaving anonymous access as last configured.
+
+Example Usage
+
+```hcl
+resource "nexus_security_anonymous" "anonymous" {
+  enabled = false
+  userid  = "anonymous"
+  realm   = "NexusAuthorizingRealm"
+}
+```
+*/
+package nexus
+
+import (
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceSecurityAnonymous() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityAnonymousCreate,
+		Read:   resourceSecurityAnonymousRead,
+		Update: resourceSecurityAnonymousUpdate,
+		Delete: resourceSecurityAnonymousDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Description: "Whether anonymous access to Nexus is enabled.",
+				Type:        schema.TypeBool,
+				Required:    true,
+			},
+			"userid": {
+				Description: "The userid under which anonymous requests are executed.",
+				Default:     "anonymous",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"realm": {
+				Description: "The realm used to authenticate anonymous requests.",
+				Default:     "NexusAuthorizingRealm",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func getSecurityAnonymousFromResourceData(d *schema.ResourceData) security.AnonymousAccessSettings {
+	return security.AnonymousAccessSettings{
+		Enabled:   d.Get("enabled").(bool),
+		UserID:    d.Get("userid").(string),
+		RealmName: d.Get("realm").(string),
+	}
+}
+
+func resourceSecurityAnonymousCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+	settings := getSecurityAnonymousFromResourceData(d)
+
+	if err := client.Security.Anonymous.Update(settings); err != nil {
+		return err
+	}
+
+	d.SetId("anonymous")
+	return resourceSecurityAnonymousRead(d, m)
+}
+
+func resourceSecurityAnonymousRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	settings, err := client.Security.Anonymous.Get()
+	if err != nil {
+		return err
+	}
+
+	d.Set("enabled", settings.Enabled)
+	d.Set("userid", settings.UserID)
+	d.Set("realm", settings.RealmName)
+
+	return nil
+}
+
+func resourceSecurityAnonymousUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+	settings := getSecurityAnonymousFromResourceData(d)
+
+	if err := client.Security.Anonymous.Update(settings); err != nil {
+		return err
+	}
+
+	return resourceSecurityAnonymousRead(d, m)
+}
+
+func resourceSecurityAnonymousDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
@@ -14,16 +14,52 @@ resource "nexus_security_user" "admin" {
   status    = "active"
 }
 ```
+
+Users provisioned by an external realm (LDAP, SAML, Crowd, ...) can be
+managed too, to assign Nexus roles without redeclaring the user itself.
+`password` is forbidden in this case, and `firstname`/`lastname`/`email`
+become optional since Nexus already knows them from the external realm.
+
+```hcl
+resource "nexus_security_user" "ldap_admin" {
+  userid = "jdoe"
+  source = "LDAP"
+  roles  = ["nx-admin"]
+}
+```
 */
 package nexus
 
 import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
 	nexus "github.com/datadrivers/go-nexus-client/nexus3"
 	"github.com/datadrivers/go-nexus-client/nexus3/schema/security"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
+const securityUserDefaultSource = "default"
+
+// securityUserRolesLocks serializes the Get-modify-Update cycles that touch a
+// given user's roles, so that nexus_security_user and multiple
+// nexus_security_user_role_assignment resources targeting the same userid
+// (which Terraform may apply concurrently) don't race and silently drop
+// each other's role changes.
+var securityUserRolesLocks sync.Map // map[string]*sync.Mutex
+
+// lockSecurityUserRoles locks the mutex for the given userid and returns a
+// function to unlock it.
+func lockSecurityUserRoles(userid string) func() {
+	value, _ := securityUserRolesLocks.LoadOrStore(userid, &sync.Mutex{})
+	mu := value.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
 func resourceSecurityUser() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSecurityUserCreate,
@@ -32,7 +68,7 @@ func resourceSecurityUser() *schema.Resource {
 		Delete: resourceSecurityUserDelete,
 		Exists: resourceSecurityUserExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceSecurityUserImportState,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -42,26 +78,60 @@ func resourceSecurityUser() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"source": {
+				Description: "The user source, e.g. `default` for the built-in realm, or `LDAP`/`SAML`/`Crowd` for an externally-provisioned user. This value cannot be changed.",
+				Default:     securityUserDefaultSource,
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
 			"firstname": {
-				Description: "The first name of the user.",
+				Description: "The first name of the user. Required unless `source` is not `default`.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"lastname": {
-				Description: "The last name of the user.",
+				Description: "The last name of the user. Required unless `source` is not `default`.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"email": {
-				Description: "The email address associated with the user.",
+				Description: "The email address associated with the user. Required unless `source` is not `default`.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 			},
 			"password": {
-				Description: "The password for the user.",
+				Description:   "The password for the user. Required when `source` is `default` unless `password_hash` is set, forbidden otherwise.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_hash"},
+			},
+			"password_hash": {
+				Description:   "A pre-computed bcrypt hash of the user's password, sent as the initial credential through the user-creation call instead of `ChangePassword`. Mutually exclusive with `password`. Nexus has no API to update a user's password hash after creation, so changing this value once the user exists is an error; destroy and recreate the resource, or use `password` with `password_rotation_days` instead.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password"},
+			},
+			"password_wo_version": {
+				Description: "Bump this value to force `password` to be re-applied via `ChangePassword` on the next apply, independent of `password_rotation_days`.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"password_rotation_days": {
+				Description: "Number of days after which `password` is re-applied via `ChangePassword`, even if its value hasn't changed in configuration. `0` (the default) disables time-based rotation. The window is only checked when an apply updates this user; the passing of time alone does not add a change to the plan.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+			},
+			"password_last_set": {
+				Description: "RFC3339 timestamp of the last time Terraform set this user's `password`, either when creating the user or via `ChangePassword`.",
 				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
+				Computed:    true,
 			},
 			"roles": {
 				Description: "The roles which the user has been assigned within Nexus.",
@@ -69,6 +139,18 @@ func resourceSecurityUser() *schema.Resource {
 				Optional:    true,
 				Type:        schema.TypeSet,
 			},
+			"roles_authoritative": {
+				Description: "Whether `roles` is the full, authoritative set of roles for this user. Set to `false` to only ever add the configured roles and leave roles added out-of-band (e.g. by `nexus_security_user_role_assignment`, or another Terraform module) untouched.",
+				Default:     true,
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"manage_lifecycle": {
+				Description: "Whether Terraform creates and deletes this user. Set to `false` for accounts whose lifecycle it doesn't own, e.g. the built-in `admin` user: Create adopts the existing user instead (`Get` then `Update`), and Delete only removes it from state, leaving it untouched in Nexus.",
+				Default:     true,
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
 			"status": {
 				Default:     "active",
 				Description: "The user's status, e.g. active or disabled.",
@@ -84,29 +166,163 @@ func resourceSecurityUser() *schema.Resource {
 }
 
 func getSecurityUserFromResourceData(d *schema.ResourceData) security.User {
+	password := d.Get("password").(string)
+	if password == "" {
+		// On creation, a bcrypt password_hash is sent through the same
+		// user-creation call as a plaintext password would be.
+		password = d.Get("password_hash").(string)
+	}
+
 	return security.User{
 		UserID:       d.Get("userid").(string),
 		FirstName:    d.Get("firstname").(string),
 		LastName:     d.Get("lastname").(string),
 		EmailAddress: d.Get("email").(string),
-		Password:     d.Get("password").(string),
+		Password:     password,
+		Source:       d.Get("source").(string),
 		Status:       d.Get("status").(string),
 		Roles:        interfaceSliceToStringSlice(d.Get("roles").(*schema.Set).List()),
 	}
 }
 
+// isDefaultSecurityUserSource reports whether the given user source is the
+// built-in realm, as opposed to an external realm such as LDAP or SAML.
+func isDefaultSecurityUserSource(source string) bool {
+	return source == "" || source == securityUserDefaultSource
+}
+
+func validateSecurityUserSourceFields(d *schema.ResourceData) error {
+	source := d.Get("source").(string)
+
+	if isDefaultSecurityUserSource(source) {
+		if d.Get("password").(string) == "" && d.Get("password_hash").(string) == "" {
+			return fmt.Errorf("one of password or password_hash is required when source is %q", securityUserDefaultSource)
+		}
+		if d.Get("firstname").(string) == "" || d.Get("lastname").(string) == "" || d.Get("email").(string) == "" {
+			return fmt.Errorf("firstname, lastname and email are required when source is %q", securityUserDefaultSource)
+		}
+		return nil
+	}
+
+	if d.Get("password").(string) != "" || d.Get("password_hash").(string) != "" {
+		return fmt.Errorf("password and password_hash are not allowed when source is %q, users from external realms manage their own credentials", source)
+	}
+	return nil
+}
+
+// securityUserPasswordRotationDue reports whether password_rotation_days has
+// elapsed since password_last_set.
+func securityUserPasswordRotationDue(d *schema.ResourceData) (bool, error) {
+	days := d.Get("password_rotation_days").(int)
+	if days <= 0 {
+		return false, nil
+	}
+
+	lastSet := d.Get("password_last_set").(string)
+	if lastSet == "" {
+		return true, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, lastSet)
+	if err != nil {
+		return false, fmt.Errorf("parsing password_last_set: %w", err)
+	}
+
+	return time.Since(t) >= time.Duration(days)*24*time.Hour, nil
+}
+
 func resourceSecurityUserCreate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
-	user := getSecurityUserFromResourceData(d)
 
-	if err := client.Security.User.Create(user); err != nil {
+	if err := validateSecurityUserSourceFields(d); err != nil {
 		return err
 	}
 
+	if !d.Get("manage_lifecycle").(bool) {
+		return resourceSecurityUserAdopt(d, m)
+	}
+
+	user := getSecurityUserFromResourceData(d)
+
+	unlock := lockSecurityUserRoles(user.UserID)
+	defer unlock()
+
+	if !d.Get("roles_authoritative").(bool) {
+		existing, err := client.Security.User.Get(user.UserID)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			user.Roles = unionStringSlices(existing.Roles, user.Roles)
+		}
+	}
+
+	if isDefaultSecurityUserSource(user.Source) {
+		if err := client.Security.User.Create(user); err != nil {
+			return err
+		}
+		d.SetId(user.UserID)
+
+		if d.Get("password").(string) != "" {
+			d.Set("password_last_set", time.Now().UTC().Format(time.RFC3339))
+		}
+	} else {
+		if err := client.Security.User.Update(user.UserID, user); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(user.UserID)
 	return resourceSecurityUserRead(d, m)
 }
 
+// resourceSecurityUserAdopt implements manage_lifecycle = false: it adopts an
+// already-existing user instead of creating one, applying the configured
+// firstname/lastname/email/status/roles via Update and, if password is set,
+// resetting the password via ChangePassword. Unlike a genuine update,
+// password_hash can never be applied here since adopting never calls Create,
+// the only place Nexus accepts a password hash.
+func resourceSecurityUserAdopt(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+
+	if d.Get("password_hash").(string) != "" {
+		return fmt.Errorf("password_hash cannot be set while adopting an existing user (manage_lifecycle = false): adopting only updates the user, it never creates one, and Nexus has no API to set a password hash outside of user creation; use password instead")
+	}
+
+	userid := d.Get("userid").(string)
+
+	unlock := lockSecurityUserRoles(userid)
+	defer unlock()
+
+	existing, err := client.Security.User.Get(userid)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("manage_lifecycle is false but no existing user found with userid: %s", userid)
+	}
+
+	user := getSecurityUserFromResourceData(d)
+
+	if !d.Get("roles_authoritative").(bool) {
+		user.Roles = unionStringSlices(existing.Roles, user.Roles)
+	}
+
+	if err := client.Security.User.Update(userid, user); err != nil {
+		return err
+	}
+
+	if password := d.Get("password").(string); password != "" {
+		if err := client.Security.User.ChangePassword(userid, password); err != nil {
+			return err
+		}
+		d.Set("password_last_set", time.Now().UTC().Format(time.RFC3339))
+	}
+
+	d.SetId(userid)
+	return resourceSecurityUserRead(d, m)
+}
+
 func resourceSecurityUserRead(d *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
@@ -124,6 +340,7 @@ func resourceSecurityUserRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("firstname", user.FirstName)
 	d.Set("lastname", user.LastName)
 	d.Set("roles", stringSliceToInterfaceSlice(user.Roles))
+	d.Set("source", user.Source)
 	d.Set("status", user.Status)
 	d.Set("userid", user.UserID)
 
@@ -133,15 +350,47 @@ func resourceSecurityUserRead(d *schema.ResourceData, m interface{}) error {
 func resourceSecurityUserUpdate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
-	if d.HasChange("password") {
-		password := d.Get("password").(string)
-		if err := client.Security.User.ChangePassword(d.Id(), password); err != nil {
+	if err := validateSecurityUserSourceFields(d); err != nil {
+		return err
+	}
+
+	source := d.Get("source").(string)
+
+	if isDefaultSecurityUserSource(source) {
+		if d.HasChange("password_hash") {
+			return fmt.Errorf("password_hash cannot be changed after creation: Nexus has no API to update a user's password hash directly, only to set it via plaintext ChangePassword; destroy and recreate the resource, or use password with password_rotation_days instead")
+		}
+
+		rotationDue, err := securityUserPasswordRotationDue(d)
+		if err != nil {
 			return err
 		}
+
+		if password := d.Get("password").(string); password != "" &&
+			(d.HasChange("password") || d.HasChange("password_wo_version") || rotationDue) {
+			if err := client.Security.User.ChangePassword(d.Id(), password); err != nil {
+				return err
+			}
+			d.Set("password_last_set", time.Now().UTC().Format(time.RFC3339))
+		}
 	}
 
 	if d.HasChange("firstname") || d.HasChange("lastname") || d.HasChange("email") || d.HasChange("status") || d.HasChange("roles") {
 		user := getSecurityUserFromResourceData(d)
+
+		if !d.Get("roles_authoritative").(bool) {
+			unlock := lockSecurityUserRoles(d.Id())
+			defer unlock()
+
+			existing, err := client.Security.User.Get(d.Id())
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				user.Roles = unionStringSlices(existing.Roles, user.Roles)
+			}
+		}
+
 		if err := client.Security.User.Update(d.Id(), user); err != nil {
 			return err
 		}
@@ -149,9 +398,31 @@ func resourceSecurityUserUpdate(d *schema.ResourceData, m interface{}) error {
 	return resourceSecurityUserRead(d, m)
 }
 
+// unionStringSlices returns the set union of a and b, without duplicates.
+func unionStringSlices(a []string, b []string) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	union := make([]string, 0, len(a)+len(b))
+
+	for _, slice := range [][]string{a, b} {
+		for _, value := range slice {
+			if !seen[value] {
+				seen[value] = true
+				union = append(union, value)
+			}
+		}
+	}
+
+	return union
+}
+
 func resourceSecurityUserDelete(d *schema.ResourceData, m interface{}) error {
 	client := m.(*nexus.NexusClient)
 
+	if !d.Get("manage_lifecycle").(bool) {
+		d.SetId("")
+		return nil
+	}
+
 	if err := client.Security.User.Delete(d.Id()); err != nil {
 		return err
 	}
@@ -166,3 +437,17 @@ func resourceSecurityUserExists(d *schema.ResourceData, m interface{}) (bool, er
 	user, err := client.Security.User.Get(d.Id())
 	return user != nil, err
 }
+
+// resourceSecurityUserImportState supports importing a user either by its
+// userid alone (assumed to be from the default source) or, for users from an
+// external realm, by a "source:userid" compound ID.
+func resourceSecurityUserImportState(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+
+	if len(parts) == 2 {
+		d.Set("source", parts[0])
+		d.SetId(parts[1])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,23 @@
+package nexus
+
+import (
+	"testing"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestResourceSecurityUserAdoptRejectsPasswordHash(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSecurityUser().Schema, map[string]interface{}{
+		"userid":           "admin",
+		"manage_lifecycle": false,
+		"password_hash":    "$2a$10$abcdefghijklmnopqrstuv",
+	})
+
+	// resourceSecurityUserAdopt must reject password_hash before it ever
+	// touches the client, so a typed nil *nexus.NexusClient is safe to pass.
+	err := resourceSecurityUserAdopt(d, (*nexus.NexusClient)(nil))
+	if err == nil {
+		t.Fatal("expected an error when adopting a user with password_hash set, got nil")
+	}
+}
@@ -0,0 +1,62 @@
+package nexus
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestResourceSecurityUserImportState(t *testing.T) {
+	cases := []struct {
+		name           string
+		id             string
+		expectSource   string
+		expectSourceOk bool
+		expectID       string
+	}{
+		{
+			name:     "plain userid, no source prefix",
+			id:       "jdoe",
+			expectID: "jdoe",
+		},
+		{
+			name:           "source:userid compound ID",
+			id:             "LDAP:jdoe",
+			expectSource:   "LDAP",
+			expectSourceOk: true,
+			expectID:       "jdoe",
+		},
+		{
+			name:           "userid itself contains a colon",
+			id:             "LDAP:dom:jdoe",
+			expectSource:   "LDAP",
+			expectSourceOk: true,
+			expectID:       "dom:jdoe",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceSecurityUser().Schema, map[string]interface{}{})
+			d.SetId(tc.id)
+
+			results, err := resourceSecurityUserImportState(d, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(results) != 1 {
+				t.Fatalf("expected 1 resource data, got %d", len(results))
+			}
+
+			got := results[0]
+			if got.Id() != tc.expectID {
+				t.Errorf("Id() = %q, want %q", got.Id(), tc.expectID)
+			}
+			if tc.expectSourceOk {
+				if source := got.Get("source").(string); source != tc.expectSource {
+					t.Errorf("source = %q, want %q", source, tc.expectSource)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Use this resource to assign a single role to a user without taking
+ownership of the user's full role set, so several Terraform modules can each
+contribute roles to the same shared user (e.g. an LDAP-backed admin) without
+fighting over the `roles` TypeSet in `nexus_security_user`.
+
+Example Usage
+
+```hcl
+resource "nexus_security_user_role_assignment" "admin_deploy" {
+  userid = "jdoe"
+  role   = "nx-deploy"
+}
+```
+*/
+package nexus
+
+import (
+	"fmt"
+	"strings"
+
+	nexus "github.com/datadrivers/go-nexus-client/nexus3"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceSecurityUserRoleAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecurityUserRoleAssignmentCreate,
+		Read:   resourceSecurityUserRoleAssignmentRead,
+		Delete: resourceSecurityUserRoleAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceSecurityUserRoleAssignmentImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"userid": {
+				Description: "The userid of the user to assign the role to.",
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"role": {
+				Description: "The role to assign to the user.",
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func resourceSecurityUserRoleAssignmentID(userid string, role string) string {
+	return fmt.Sprintf("%s:%s", userid, role)
+}
+
+func resourceSecurityUserRoleAssignmentCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+	userid := d.Get("userid").(string)
+	role := d.Get("role").(string)
+
+	unlock := lockSecurityUserRoles(userid)
+	defer unlock()
+
+	user, err := client.Security.User.Get(userid)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return fmt.Errorf("no user found with userid: %s", userid)
+	}
+
+	if !stringSliceContains(user.Roles, role) {
+		user.Roles = append(user.Roles, role)
+		if err := client.Security.User.Update(userid, *user); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(resourceSecurityUserRoleAssignmentID(userid, role))
+	return resourceSecurityUserRoleAssignmentRead(d, m)
+}
+
+func resourceSecurityUserRoleAssignmentRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+	userid := d.Get("userid").(string)
+	role := d.Get("role").(string)
+
+	user, err := client.Security.User.Get(userid)
+	if err != nil {
+		return err
+	}
+	if user == nil || !stringSliceContains(user.Roles, role) {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("userid", userid)
+	d.Set("role", role)
+	return nil
+}
+
+func resourceSecurityUserRoleAssignmentDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*nexus.NexusClient)
+	userid := d.Get("userid").(string)
+	role := d.Get("role").(string)
+
+	unlock := lockSecurityUserRoles(userid)
+	defer unlock()
+
+	user, err := client.Security.User.Get(userid)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		d.SetId("")
+		return nil
+	}
+
+	remaining := make([]string, 0, len(user.Roles))
+	for _, r := range user.Roles {
+		if r != role {
+			remaining = append(remaining, r)
+		}
+	}
+
+	if len(remaining) != len(user.Roles) {
+		user.Roles = remaining
+		if err := client.Security.User.Update(userid, *user); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceSecurityUserRoleAssignmentImportState supports importing via the
+// "userid:role" compound ID used by this resource.
+func resourceSecurityUserRoleAssignmentImportState(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid import ID %q, expected format: userid:role", d.Id())
+	}
+
+	d.Set("userid", parts[0])
+	d.Set("role", parts[1])
+	d.SetId(resourceSecurityUserRoleAssignmentID(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
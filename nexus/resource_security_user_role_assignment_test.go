@@ -0,0 +1,101 @@
+package nexus
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestUnionStringSlices(t *testing.T) {
+	cases := []struct {
+		name string
+		a    []string
+		b    []string
+		want []string
+	}{
+		{
+			name: "disjoint slices",
+			a:    []string{"nx-admin"},
+			b:    []string{"nx-deploy"},
+			want: []string{"nx-admin", "nx-deploy"},
+		},
+		{
+			name: "overlapping slices are deduplicated",
+			a:    []string{"nx-admin", "nx-deploy"},
+			b:    []string{"nx-deploy", "nx-anonymous"},
+			want: []string{"nx-admin", "nx-deploy", "nx-anonymous"},
+		},
+		{
+			name: "both empty",
+			a:    nil,
+			b:    nil,
+			want: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := unionStringSlices(tc.a, tc.b)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("unionStringSlices(%v, %v) = %v, want %v", tc.a, tc.b, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestResourceSecurityUserRoleAssignmentID(t *testing.T) {
+	got := resourceSecurityUserRoleAssignmentID("jdoe", "nx-deploy")
+	want := "jdoe:nx-deploy"
+	if got != want {
+		t.Errorf("resourceSecurityUserRoleAssignmentID() = %q, want %q", got, want)
+	}
+}
+
+func TestResourceSecurityUserRoleAssignmentImportState(t *testing.T) {
+	t.Run("valid userid:role ID", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resourceSecurityUserRoleAssignment().Schema, map[string]interface{}{})
+		d.SetId("jdoe:nx-deploy")
+
+		results, err := resourceSecurityUserRoleAssignmentImportState(d, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(results) != 1 {
+			t.Fatalf("expected 1 resource data, got %d", len(results))
+		}
+
+		got := results[0]
+		if got.Id() != "jdoe:nx-deploy" {
+			t.Errorf("Id() = %q, want %q", got.Id(), "jdoe:nx-deploy")
+		}
+		if userid := got.Get("userid").(string); userid != "jdoe" {
+			t.Errorf("userid = %q, want %q", userid, "jdoe")
+		}
+		if role := got.Get("role").(string); role != "nx-deploy" {
+			t.Errorf("role = %q, want %q", role, "nx-deploy")
+		}
+	})
+
+	t.Run("role itself contains a colon", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resourceSecurityUserRoleAssignment().Schema, map[string]interface{}{})
+		d.SetId("jdoe:nx-repository-view:maven2:public:browse")
+
+		results, err := resourceSecurityUserRoleAssignmentImportState(d, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if role := results[0].Get("role").(string); role != "nx-repository-view:maven2:public:browse" {
+			t.Errorf("role = %q, want %q", role, "nx-repository-view:maven2:public:browse")
+		}
+	})
+
+	t.Run("missing colon is an error", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resourceSecurityUserRoleAssignment().Schema, map[string]interface{}{})
+		d.SetId("jdoe")
+
+		if _, err := resourceSecurityUserRoleAssignmentImportState(d, nil); err == nil {
+			t.Fatal("expected an error for a malformed import ID, got nil")
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package nexus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestSecurityUserPasswordRotationDue(t *testing.T) {
+	cases := []struct {
+		name         string
+		rotationDays int
+		lastSet      string
+		wantDue      bool
+		wantErr      bool
+	}{
+		{
+			name:         "rotation disabled",
+			rotationDays: 0,
+			lastSet:      time.Now().UTC().Add(-365 * 24 * time.Hour).Format(time.RFC3339),
+			wantDue:      false,
+		},
+		{
+			name:         "negative rotation days treated as disabled",
+			rotationDays: -1,
+			lastSet:      time.Now().UTC().Add(-365 * 24 * time.Hour).Format(time.RFC3339),
+			wantDue:      false,
+		},
+		{
+			name:         "password_last_set unset is immediately due",
+			rotationDays: 30,
+			lastSet:      "",
+			wantDue:      true,
+		},
+		{
+			name:         "rotation window not yet elapsed",
+			rotationDays: 30,
+			lastSet:      time.Now().UTC().Add(-1 * time.Hour).Format(time.RFC3339),
+			wantDue:      false,
+		},
+		{
+			name:         "rotation window elapsed",
+			rotationDays: 30,
+			lastSet:      time.Now().UTC().Add(-31 * 24 * time.Hour).Format(time.RFC3339),
+			wantDue:      true,
+		},
+		{
+			name:         "unparseable password_last_set is an error",
+			rotationDays: 30,
+			lastSet:      "not-a-timestamp",
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceSecurityUser().Schema, map[string]interface{}{
+				"password_rotation_days": tc.rotationDays,
+				"password_last_set":      tc.lastSet,
+			})
+
+			due, err := securityUserPasswordRotationDue(d)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if due != tc.wantDue {
+				t.Errorf("securityUserPasswordRotationDue() = %v, want %v", due, tc.wantDue)
+			}
+		})
+	}
+}